@@ -0,0 +1,128 @@
+package dyn
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Assocer is any type that knows how to associate a value with a key
+// on its self and return the (possibly new) resulting value.
+type Assocer interface {
+	Assoc(key, value interface{}) interface{}
+}
+
+// Assoc associates a value with a key in an associative object. If
+// the type of obj is an Assocer then Assoc will be called and the
+// result returned. Otherwise reflection will be used to do the
+// association on native go types. If the type is a struct it may be
+// indexed by an integer or a string, any other index type will panic.
+// If the type is a map then the key is used to set the value directly,
+// if the key is of the wrong type then Assoc will panic. If the type
+// is a slice then the key must be an int and in range, otherwise Assoc
+// will panic. If the type is a pointer to any of the above then the
+// pointee is mutated in place and obj is returned unchanged, otherwise
+// a new value reflecting the change is returned.
+func Assoc(obj interface{}, key, value interface{}) interface{} {
+	o, ok := obj.(Assocer)
+	if ok {
+		return o.Assoc(key, value)
+	}
+	return assocReflect(reflect.ValueOf(obj), key, value)
+}
+
+func assocReflect(objv reflect.Value, key, value interface{}) interface{} {
+	switch objv.Kind() {
+	case reflect.Ptr:
+		assocReflectSet(objv.Elem(), key, value)
+		return objv.Interface()
+	case reflect.Struct:
+		out := reflect.New(objv.Type()).Elem()
+		out.Set(objv)
+		assocReflectSet(out, key, value)
+		return out.Interface()
+	case reflect.Map:
+		out := reflect.MakeMapWithSize(objv.Type(), objv.Len())
+		iter := objv.MapRange()
+		for iter.Next() {
+			out.SetMapIndex(iter.Key(), iter.Value())
+		}
+		assocReflectSet(out, key, value)
+		return out.Interface()
+	case reflect.Slice:
+		out := reflect.MakeSlice(objv.Type(), objv.Len(), objv.Len())
+		reflect.Copy(out, objv)
+		assocReflectSet(out, key, value)
+		return out.Interface()
+	default:
+		panic(errors.New("Assoc passed a non associative type"))
+	}
+}
+
+func assocReflectSet(objv reflect.Value, key, value interface{}) {
+	switch objv.Kind() {
+	case reflect.Struct:
+		switch k := key.(type) {
+		case int:
+			if k < 0 || k >= objv.NumField() {
+				panic(errors.New("Assoc index out of range"))
+			}
+			objv.Field(k).Set(reflect.ValueOf(value))
+		case string:
+			field := objv.FieldByName(k)
+			if !field.IsValid() {
+				panic(errors.New("Assoc passed an unknown field name"))
+			}
+			field.Set(reflect.ValueOf(value))
+		default:
+			panic(errors.New("structs can only be referenced by index or name"))
+		}
+	case reflect.Map:
+		if objv.IsNil() {
+			objv.Set(reflect.MakeMap(objv.Type()))
+		}
+		objv.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(value))
+	case reflect.Slice:
+		idx := key.(int)
+		if idx < 0 || idx >= objv.Len() {
+			panic(errors.New("Assoc index out of range"))
+		}
+		objv.Index(idx).Set(reflect.ValueOf(value))
+	default:
+		panic(errors.New("Assoc passed a non associative type"))
+	}
+}
+
+// GetIn walks obj following path, using Find at each step, and
+// returns the value found at the end of path and whether it was
+// found. If any intermediate step is not found then GetIn stops and
+// returns (nil, false).
+func GetIn(obj interface{}, path ...interface{}) (interface{}, bool) {
+	cur := obj
+	for _, key := range path {
+		out, ok := Find(cur, key)
+		if !ok {
+			return nil, false
+		}
+		cur = out
+	}
+	return cur, true
+}
+
+// AssocIn associates value at the location in obj specified by path,
+// creating intermediate lookups with Find and rebuilding each level
+// with Assoc on the way back out. AssocIn panics if any intermediate
+// element of path does not exist or does not support Find/Assoc.
+func AssocIn(obj interface{}, path []interface{}, value interface{}) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+	key := path[0]
+	if len(path) == 1 {
+		return Assoc(obj, key, value)
+	}
+	child, ok := Find(obj, key)
+	if !ok {
+		panic(errors.New("AssocIn passed a path that does not exist"))
+	}
+	return Assoc(obj, key, AssocIn(child, path[1:], value))
+}
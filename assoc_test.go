@@ -0,0 +1,67 @@
+package dyn
+
+import (
+	"fmt"
+)
+
+func ExampleAssoc_struct() {
+	type point struct{ X, Y int }
+	p := point{X: 1, Y: 2}
+	fmt.Println(Assoc(p, "Y", 20))
+	fmt.Println(p)
+	// Output: {1 20}
+	// {1 2}
+}
+
+func ExampleAssoc_structPointer() {
+	type point struct{ X, Y int }
+	p := &point{X: 1, Y: 2}
+	Assoc(p, "Y", 20)
+	fmt.Println(*p)
+	// Output: {1 20}
+}
+
+func ExampleAssoc_map() {
+	m := map[string]int{"a": 1}
+	out := Assoc(m, "b", 2)
+	fmt.Println(out)
+	fmt.Println(m)
+	// Output: map[a:1 b:2]
+	// map[a:1]
+}
+
+func ExampleAssoc_slice() {
+	s := []int{1, 2, 3}
+	out := Assoc(s, 0, 99)
+	fmt.Println(out)
+	fmt.Println(s)
+	// Output: [99 2 3]
+	// [1 2 3]
+}
+
+func ExampleGetIn() {
+	data := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": []int{10, 20, 30},
+		},
+	}
+	out, ok := GetIn(data, "a", "b", 1)
+	fmt.Println(out, ok)
+	_, ok = GetIn(data, "a", "c")
+	fmt.Println(ok)
+	// Output: 20 true
+	// false
+}
+
+func ExampleAssocIn() {
+	data := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": 1,
+		},
+	}
+	out := AssocIn(data, []interface{}{"a", "b"}, 2)
+	fmt.Println(At(At(out, "a"), "b"))
+	fmt.Println(At(At(data, "a"), "b"))
+	// Output: 2
+	// 1
+}
@@ -0,0 +1,177 @@
+package dyn
+
+import (
+	"reflect"
+	"sync"
+)
+
+// methodCache memoizes method name to method index lookups for a
+// single type, since reflect.Value.MethodByName walks every method
+// name on each call.
+type methodCache struct {
+	index map[string]int
+}
+
+// fieldCache memoizes field name to field index-path lookups for a
+// single struct type, since reflect.Value.FieldByName walks every
+// field name, including those promoted from embedded structs, on each
+// call. The index path mirrors what FieldByName would resolve to: the
+// shallowest field wins, and a name reachable at the same depth through
+// more than one embedded field is ambiguous and omitted, just as
+// FieldByName reports it as not found.
+type fieldCache struct {
+	index map[string][]int
+}
+
+// fnCache memoizes the parameter types and kinds of a function type so
+// apply does not need to call fnt.In(i) and Kind() on every
+// invocation.
+type fnCache struct {
+	in    []reflect.Type
+	kinds []reflect.Kind
+}
+
+var (
+	methodCaches sync.Map // map[reflect.Type]*methodCache
+	fieldCaches  sync.Map // map[reflect.Type]*fieldCache
+	fnCaches     sync.Map // map[reflect.Type]*fnCache
+)
+
+// ClearCache discards all memoized method, field, and function
+// signature lookups used by Send, Find, and Apply. It is primarily
+// useful in tests that redefine types with the same reflect.Type
+// identity between runs.
+func ClearCache() {
+	methodCaches = sync.Map{}
+	fieldCaches = sync.Map{}
+	fnCaches = sync.Map{}
+}
+
+func getMethodCache(t reflect.Type) *methodCache {
+	if v, ok := methodCaches.Load(t); ok {
+		return v.(*methodCache)
+	}
+	index := make(map[string]int, t.NumMethod())
+	for i := 0; i < t.NumMethod(); i++ {
+		index[t.Method(i).Name] = i
+	}
+	actual, _ := methodCaches.LoadOrStore(t, &methodCache{index: index})
+	return actual.(*methodCache)
+}
+
+// cachedMethodByName is a cached equivalent of reflect.Value.MethodByName.
+func cachedMethodByName(rcvrv reflect.Value, name string) reflect.Value {
+	idx, ok := getMethodCache(rcvrv.Type()).index[name]
+	if !ok {
+		return reflect.Value{}
+	}
+	return rcvrv.Method(idx)
+}
+
+func getFieldCache(t reflect.Type) *fieldCache {
+	if v, ok := fieldCaches.Load(t); ok {
+		return v.(*fieldCache)
+	}
+	actual, _ := fieldCaches.LoadOrStore(t, &fieldCache{index: buildFieldIndex(t)})
+	return actual.(*fieldCache)
+}
+
+// fieldCandidate tracks the shallowest index path found so far for a
+// field name while buildFieldIndex walks the embedding tree
+// breadth-first.
+type fieldCandidate struct {
+	path  []int
+	depth int
+}
+
+// buildFieldIndex walks t and its embedded structs breadth-first,
+// recording for each reachable field name the index path FieldByIndex
+// needs to reach it. It reproduces FieldByName's promotion rules: the
+// shallowest occurrence of a name wins, and a name reachable at the
+// same depth through more than one embedded field is ambiguous and
+// left out of the index.
+func buildFieldIndex(t reflect.Type) map[string][]int {
+	type frame struct {
+		t     reflect.Type
+		path  []int
+		depth int
+	}
+	found := make(map[string]fieldCandidate)
+	ambiguous := make(map[string]bool)
+	// visited records the depth at which each struct type was first
+	// walked; a type seen again deeper down can only promote names that
+	// are already settled, and skipping it stops recursive embedding.
+	visited := make(map[reflect.Type]int)
+	queue := []frame{{t: t, depth: 0}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if cur.t.Kind() != reflect.Struct {
+			continue
+		}
+		if d, ok := visited[cur.t]; ok && d < cur.depth {
+			continue
+		}
+		visited[cur.t] = cur.depth
+		for i := 0; i < cur.t.NumField(); i++ {
+			f := cur.t.Field(i)
+			path := make([]int, len(cur.path)+1)
+			copy(path, cur.path)
+			path[len(cur.path)] = i
+
+			if existing, ok := found[f.Name]; ok {
+				if existing.depth == cur.depth {
+					ambiguous[f.Name] = true
+				}
+				// a shallower occurrence already wins; a deeper one
+				// is shadowed. Either way this field's own name is
+				// settled, but it may still promote other, distinct
+				// names from its own embedded fields below.
+			} else {
+				found[f.Name] = fieldCandidate{path: path, depth: cur.depth}
+			}
+
+			if f.Anonymous {
+				ft := f.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					queue = append(queue, frame{t: ft, path: path, depth: cur.depth + 1})
+				}
+			}
+		}
+	}
+	index := make(map[string][]int, len(found))
+	for name, c := range found {
+		if ambiguous[name] {
+			continue
+		}
+		index[name] = c.path
+	}
+	return index
+}
+
+// cachedFieldByName is a cached equivalent of reflect.Value.FieldByName.
+func cachedFieldByName(objv reflect.Value, name string) reflect.Value {
+	path, ok := getFieldCache(objv.Type()).index[name]
+	if !ok {
+		return reflect.Value{}
+	}
+	return objv.FieldByIndex(path)
+}
+
+func getFnCache(t reflect.Type) *fnCache {
+	if v, ok := fnCaches.Load(t); ok {
+		return v.(*fnCache)
+	}
+	n := t.NumIn()
+	in := make([]reflect.Type, n)
+	kinds := make([]reflect.Kind, n)
+	for i := 0; i < n; i++ {
+		in[i] = t.In(i)
+		kinds[i] = in[i].Kind()
+	}
+	actual, _ := fnCaches.LoadOrStore(t, &fnCache{in: in, kinds: kinds})
+	return actual.(*fnCache)
+}
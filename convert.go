@@ -0,0 +1,50 @@
+package dyn
+
+import "reflect"
+
+// Coercer is any type that knows how to convert its self to a target
+// reflect.Type. Convert tries Coerce when the value is not already
+// assignable to the target type, allowing a user type to opt in to
+// conversions that are not expressible through Go's own assignability
+// or ConvertibleTo rules.
+type Coercer interface {
+	Coerce(target reflect.Type) (interface{}, bool)
+}
+
+// Convert attempts to convert v to target, returning the converted
+// value and true on success. If v is already assignable to target, v
+// is returned unchanged. Otherwise, if v implements Coercer, Coerce is
+// tried. Failing that, if v's type is convertible to target in the
+// sense of reflect.Type.ConvertibleTo (the same rule a Go type
+// conversion uses), the converted value is returned, except that a
+// numeric value is never converted to a string. If none of these
+// apply, Convert returns (nil, false).
+func Convert(v interface{}, target reflect.Type) (interface{}, bool) {
+	vt := reflect.TypeOf(v)
+	if vt == nil {
+		return nil, false
+	}
+	if vt.AssignableTo(target) {
+		return v, true
+	}
+	if c, ok := v.(Coercer); ok {
+		if out, ok := c.Coerce(target); ok {
+			return out, true
+		}
+	}
+	if isNumericKind(vt.Kind()) && target.Kind() == reflect.String {
+		// Go's numeric-to-string conversion treats the number as a
+		// rune, e.g. string(65) == "A", which is never what a caller
+		// passing a mismatched argument meant. Exclude it rather than
+		// silently mangling the value.
+		return nil, false
+	}
+	if vt.ConvertibleTo(target) {
+		return reflect.ValueOf(v).Convert(target).Interface(), true
+	}
+	return nil, false
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	return isIntKind(k) || isUintKind(k) || isFloatKind(k)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 // Applier is any type that knows how to apply arguments to its self
@@ -36,11 +37,12 @@ func apply(fnv reflect.Value, args ...interface{}) interface{} {
 		out, _ := findReflect(fnv, args[0])
 		return out
 	}
+	fnc := getFnCache(fnt)
 	argvs := make([]reflect.Value, len(args))
 	for i, arg := range args {
 		if arg == nil {
-			fnint := fnt.In(i)
-			fnink := fnint.Kind()
+			fnint := fnc.in[i]
+			fnink := fnc.kinds[i]
 			switch fnink {
 			case reflect.Chan, reflect.Func,
 				reflect.Interface, reflect.Map,
@@ -51,6 +53,12 @@ func apply(fnv reflect.Value, args ...interface{}) interface{} {
 				// intended
 				argvs[i] = reflect.ValueOf(arg)
 			}
+		} else if i < len(fnc.in) {
+			if converted, ok := Convert(arg, fnc.in[i]); ok {
+				argvs[i] = reflect.ValueOf(converted)
+			} else {
+				argvs[i] = reflect.ValueOf(arg)
+			}
 		} else {
 			argvs[i] = reflect.ValueOf(arg)
 		}
@@ -150,7 +158,7 @@ func findReflect(objv reflect.Value, selector interface{}) (interface{}, bool) {
 			}
 			return objv.Field(s).Interface(), true
 		case string:
-			out := objv.FieldByName(s)
+			out := cachedFieldByName(objv, s)
 			if !out.IsValid() {
 				return nil, false
 			}
@@ -222,7 +230,7 @@ func Send(rcvr interface{}, message ...interface{}) interface{} {
 		return r.Receive(message...)
 	}
 	rcvrv := reflect.ValueOf(rcvr)
-	method := rcvrv.MethodByName(message[0].(string))
+	method := cachedMethodByName(rcvrv, message[0].(string))
 	if !method.IsValid() {
 		panic(DoesNotUnderstand(rcvr, message...))
 	}
@@ -250,7 +258,35 @@ func Equal(one, two interface{}) bool {
 		return v.Equal(one)
 	}
 
-	return one == two
+	if one == two {
+		return true
+	}
+	return equalReflect(one, two)
+}
+
+// equalReflect compares one and two by their reflect.Kind when they
+// are not already == equal, so that defined types sharing an
+// underlying numeric or string kind (including across distinct
+// defined types, e.g. comparing a MyInt to a plain int) compare equal
+// without requiring an Equaler.
+func equalReflect(one, two interface{}) bool {
+	if one == nil || two == nil {
+		return false
+	}
+	v1, v2 := reflect.ValueOf(one), reflect.ValueOf(two)
+	k1, k2 := v1.Kind(), v2.Kind()
+	switch {
+	case isIntKind(k1) && isIntKind(k2):
+		return v1.Int() == v2.Int()
+	case isUintKind(k1) && isUintKind(k2):
+		return v1.Uint() == v2.Uint()
+	case isFloatKind(k1) && isFloatKind(k2):
+		return v1.Float() == v2.Float()
+	case k1 == reflect.String && k2 == reflect.String:
+		return v1.String() == v2.String()
+	default:
+		return false
+	}
 }
 
 // EqualNonComparable is a version of Equal that is safe for
@@ -295,136 +331,176 @@ func Compare(one, two interface{}) int {
 	}
 	switch v1 := one.(type) {
 	case uint:
-		v2 := two.(uint)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(uint)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case uint8:
-		v2 := two.(uint8)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(uint8)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case uint16:
-		v2 := two.(uint16)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(uint16)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case uint32:
-		v2 := two.(uint32)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(uint32)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case uint64:
-		v2 := two.(uint64)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(uint64)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case int:
-		v2 := two.(int)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(int)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case int8:
-		v2 := two.(int8)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(int8)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case int16:
-		v2 := two.(int16)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(int16)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case int32:
-		v2 := two.(int32)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(int32)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case int64:
-		v2 := two.(int64)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(int64)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case float32:
-		v2 := two.(float32)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(float32)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case float64:
-		v2 := two.(float64)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(float64)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	case string:
-		v2 := two.(string)
-		switch {
-		case v1 < v2:
-			return -1
-		case v1 > v2:
-			return 1
-		default:
-			return 0
+		v2, ok := two.(string)
+		if !ok {
+			return compareReflect(one, two)
 		}
+		return compareOrdered(v1, v2)
 	default:
-		return one.(Comparer).Compare(two)
+		if c, ok := one.(Comparer); ok {
+			return c.Compare(two)
+		}
+		return compareReflect(one, two)
+	}
+}
+
+// compareReflect compares one and two by their reflect.Kind, bridging
+// defined types (e.g. type MyInt int) to the same ordering as their
+// underlying primitive kind. It panics unless both values are of an
+// integer kind, both of an unsigned integer kind, both of a float kind,
+// or both of a string kind.
+func compareReflect(one, two interface{}) int {
+	v1, v2 := reflect.ValueOf(one), reflect.ValueOf(two)
+	switch {
+	case isIntKind(v1.Kind()) && isIntKind(v2.Kind()):
+		return compareOrdered(v1.Int(), v2.Int())
+	case isUintKind(v1.Kind()) && isUintKind(v2.Kind()):
+		return compareOrdered(v1.Uint(), v2.Uint())
+	case isFloatKind(v1.Kind()) && isFloatKind(v2.Kind()):
+		return compareOrdered(v1.Float(), v2.Float())
+	case v1.Kind() == reflect.String && v2.Kind() == reflect.String:
+		return compareOrdered(v1.String(), v2.String())
+	default:
+		panic(fmt.Errorf("dyn: %T is not a Comparer and has no orderable underlying kind", one))
+	}
+}
+
+type ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+func compareOrdered[T ordered](v1, v2 T) int {
+	switch {
+	case v1 < v2:
+		return -1
+	case v1 > v2:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func isIntKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUintKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// CompareFold is a variant of Compare that compares strings
+// case-insensitively; defined types with an underlying string kind are
+// included, since foldString checks the reflect kind. All other types
+// compare identically to Compare.
+func CompareFold(one, two interface{}) int {
+	s1, ok1 := foldString(one)
+	s2, ok2 := foldString(two)
+	if ok1 && ok2 {
+		return compareOrdered(strings.ToLower(s1), strings.ToLower(s2))
+	}
+	return Compare(one, two)
+}
+
+func foldString(v interface{}) (string, bool) {
+	if v == nil {
+		return "", false
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.String {
+		return "", false
 	}
+	return rv.String(), true
 }
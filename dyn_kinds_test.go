@@ -0,0 +1,44 @@
+package dyn
+
+import "fmt"
+
+type myInt int
+
+type myString string
+
+func ExampleEqual_definedType() {
+	fmt.Println(Equal(myInt(1), myInt(1)))
+	fmt.Println(Equal(myInt(1), myInt(2)))
+	// Output: true
+	// false
+}
+
+func ExampleEqual_definedTypeCrossKind() {
+	fmt.Println(Equal(myInt(1), 1))
+	fmt.Println(Equal(myString("a"), "a"))
+	// Output: true
+	// true
+}
+
+func ExampleCompare_definedType() {
+	fmt.Println(Compare(myInt(1), myInt(2)))
+	fmt.Println(Compare(myString("b"), myString("a")))
+	// Output: -1
+	// 1
+}
+
+func ExampleCompare_definedTypeCrossKind() {
+	fmt.Println(Compare(1, myInt(2)))
+	fmt.Println(Compare(myInt(1), 2))
+	// Output: -1
+	// -1
+}
+
+func ExampleCompareFold() {
+	fmt.Println(CompareFold("ABC", "abc"))
+	fmt.Println(CompareFold("abc", "abd"))
+	fmt.Println(CompareFold(1, 2))
+	// Output: 0
+	// -1
+	// -1
+}
@@ -3,6 +3,7 @@ package dyn
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -326,3 +327,54 @@ func ExamplePrependArg() {
 	fmt.Println(args)
 	// Output: [a b c d e]
 }
+
+func BenchmarkSend(b *testing.B) {
+	r := &receiver{}
+	for i := 0; i < b.N; i++ {
+		_ = Send(r, "String")
+	}
+}
+
+func ExampleConvert() {
+	out, ok := Convert(int32(5), reflect.TypeOf(int64(0)))
+	fmt.Println(out, ok)
+	// Output: 5 true
+}
+
+func ExampleConvert_apply() {
+	fmt.Println(Apply(func(x int64) int64 { return x * 2 }, int32(21)))
+	// Output: 42
+}
+
+func ExampleConvert_mismatch() {
+	out, ok := Convert(65, reflect.TypeOf(""))
+	fmt.Println(out, ok)
+	// Output: <nil> false
+}
+
+type fixedWidth struct {
+	n int
+}
+
+func (f fixedWidth) Coerce(target reflect.Type) (interface{}, bool) {
+	if target.Kind() != reflect.String {
+		return nil, false
+	}
+	return fmt.Sprintf("%03d", f.n), true
+}
+
+func ExampleConvert_coercer() {
+	out, ok := Convert(fixedWidth{n: 7}, reflect.TypeOf(""))
+	fmt.Println(out, ok)
+	// Output: 007 true
+}
+
+func BenchmarkFindStruct(b *testing.B) {
+	type point struct {
+		X, Y int
+	}
+	p := point{X: 1, Y: 2}
+	for i := 0; i < b.N; i++ {
+		_, _ = Find(p, "Y")
+	}
+}
@@ -0,0 +1,63 @@
+package dyn
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Apply1 is a generic wrapper around Apply that unboxes the result
+// with a single type assertion to R. It panics with a descriptive
+// error if that assertion fails, which includes a nil result.
+func Apply1[R any](fn interface{}, args ...interface{}) R {
+	return assertType[R](Apply(fn, args...))
+}
+
+// Send1 is a generic wrapper around Send that unboxes the result
+// with a single type assertion to R. It panics with a descriptive
+// error if that assertion fails, which includes a nil result.
+func Send1[R any](rcvr interface{}, msg string, args ...interface{}) R {
+	return assertType[R](Send(rcvr, PrependArg(msg, args...)...))
+}
+
+// At2 is a generic wrapper around Find that unboxes the found value
+// with a single type assertion to V. It panics with a descriptive
+// error if a value is found but that assertion fails, which includes a
+// found nil value. If no value
+// is found then the zero value of V and false are returned.
+func At2[K comparable, V any](obj interface{}, key K) (V, bool) {
+	out, ok := Find(obj, key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return assertType[V](out), true
+}
+
+// Bind1 is a generic wrapper around Bind that defers application of
+// fn until the returned function is called, unboxing the result with
+// a single type assertion to R.
+func Bind1[R any](fn interface{}, args ...interface{}) func() R {
+	return func() R {
+		return Apply1[R](fn, args...)
+	}
+}
+
+// Compose2 composes two functions with distinct argument and return
+// types, equivalent to Compose but type-safe: Compose2(f, g)(a) is
+// f(g(a)).
+func Compose2[A, B, C any](f func(B) C, g func(A) B) func(A) C {
+	return func(a A) C {
+		return f(g(a))
+	}
+}
+
+// assertType performs the single type assertion shared by the
+// generic wrappers, panicking with a message describing the expected
+// and actual types when the assertion fails.
+func assertType[T any](v interface{}) T {
+	out, ok := v.(T)
+	if !ok {
+		panic(fmt.Errorf("dyn: expected type %v but got %T", reflect.TypeOf((*T)(nil)).Elem(), v))
+	}
+	return out
+}
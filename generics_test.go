@@ -0,0 +1,50 @@
+package dyn
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleApply1() {
+	fmt.Println(Apply1[int](func(x int) int { return x * x }, 10))
+	// Output: 100
+}
+
+func TestApply1Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Apply1 to panic on a type mismatch")
+		}
+	}()
+	Apply1[string](func(x int) int { return x }, 10)
+}
+
+func ExampleSend1() {
+	fmt.Println(Send1[string](&receiver{}, "String"))
+	// Output: rcvr!
+}
+
+func ExampleAt2() {
+	type point struct{ X, Y int }
+	p := point{X: 1, Y: 2}
+	y, ok := At2[string, int](p, "Y")
+	fmt.Println(y, ok)
+	missing, ok := At2[string, int](p, "Z")
+	fmt.Println(missing, ok)
+	// Output: 2 true
+	// 0 false
+}
+
+func ExampleBind1() {
+	deferred := Bind1[int](func(x int) int { return x * x }, 10)
+	fmt.Println(deferred())
+	// Output: 100
+}
+
+func ExampleCompose2() {
+	square := func(x int) int { return x * x }
+	itoa := func(x int) string { return fmt.Sprintf("<%d>", x) }
+	composed := Compose2[int, int, string](itoa, square)
+	fmt.Println(composed(5))
+	// Output: <25>
+}
@@ -0,0 +1,140 @@
+package dyn
+
+import "sync"
+
+// defaultType is the type of the Default dispatch value sentinel.
+type defaultType struct{}
+
+// Default is the dispatch value used to register a catch-all method
+// on a Multi, invoked when no other defined or derived dispatch value
+// matches.
+var Default = defaultType{}
+
+type multiMethod struct {
+	value  interface{}
+	method interface{}
+}
+
+type multiParent struct {
+	child  interface{}
+	parent interface{}
+}
+
+// Multi implements open, extensible dispatch in the style of Clojure
+// multimethods. A Multi is itself an Applier: Apply(m, args...) runs
+// m's dispatch function on args to obtain a dispatch value, then
+// walks the methods and derivation hierarchy defined with Define and
+// Derive to find the method to apply.
+type Multi struct {
+	mu       sync.RWMutex
+	dispatch interface{}
+	methods  []multiMethod
+	parents  []multiParent
+	def      interface{}
+}
+
+// NewMulti creates a Multi that dispatches by applying dispatchFn to
+// the arguments given to Apply.
+func NewMulti(dispatchFn interface{}) *Multi {
+	return &Multi{dispatch: dispatchFn}
+}
+
+// Define registers method to be applied when the dispatch function
+// returns a value Equal to dispatchValue. Passing Default as
+// dispatchValue registers method as the fallback used when no other
+// dispatch value, nor any of its ancestors, matches. Calling Define
+// again with an Equal dispatchValue replaces the previously defined
+// method.
+func (m *Multi) Define(dispatchValue, method interface{}) *Multi {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := dispatchValue.(defaultType); ok {
+		m.def = method
+		return m
+	}
+	for i, me := range m.methods {
+		if Equal(me.value, dispatchValue) {
+			m.methods[i].method = method
+			return m
+		}
+	}
+	m.methods = append(m.methods, multiMethod{
+		value:  dispatchValue,
+		method: method,
+	})
+	return m
+}
+
+// Derive declares that child is a child of parent in the dispatch
+// hierarchy, so that a dispatch value Equal to child will fall back to
+// the method defined for parent (or one of parent's own ancestors) if
+// child has no method of its own.
+func (m *Multi) Derive(child, parent interface{}) *Multi {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.parents = append(m.parents, multiParent{child: child, parent: parent})
+	return m
+}
+
+// Apply implements Applier. It runs the dispatch function on args to
+// get a dispatch value, finds the method defined for that value or
+// its nearest ancestor, and applies it to the original args. If no
+// method is found and no Default method was defined, Apply panics
+// with ErrDoesNotUnderstand.
+func (m *Multi) Apply(args ...interface{}) interface{} {
+	dv := Apply(m.dispatch, args...)
+	method, ok := m.lookup(dv)
+	if !ok {
+		panic(DoesNotUnderstand(m, dv))
+	}
+	return Apply(method, args...)
+}
+
+func (m *Multi) lookup(dv interface{}) (interface{}, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if method, ok := m.methodFor(dv); ok {
+		return method, true
+	}
+	queue := []interface{}{dv}
+	var seen []interface{}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if seenContains(seen, cur) {
+			continue
+		}
+		seen = append(seen, cur)
+		for _, p := range m.parents {
+			if !Equal(p.child, cur) {
+				continue
+			}
+			if method, ok := m.methodFor(p.parent); ok {
+				return method, true
+			}
+			queue = append(queue, p.parent)
+		}
+	}
+	if m.def != nil {
+		return m.def, true
+	}
+	return nil, false
+}
+
+func (m *Multi) methodFor(dv interface{}) (interface{}, bool) {
+	for _, me := range m.methods {
+		if Equal(me.value, dv) {
+			return me.method, true
+		}
+	}
+	return nil, false
+}
+
+func seenContains(seen []interface{}, v interface{}) bool {
+	for _, s := range seen {
+		if Equal(s, v) {
+			return true
+		}
+	}
+	return false
+}
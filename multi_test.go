@@ -0,0 +1,49 @@
+package dyn
+
+import "fmt"
+
+func ExampleMulti() {
+	area := NewMulti(func(shape map[string]interface{}) interface{} {
+		return shape["kind"]
+	})
+	area.Define("circle", func(shape map[string]interface{}) interface{} {
+		r := shape["r"].(float64)
+		return r * r * 3
+	})
+	area.Define("square", func(shape map[string]interface{}) interface{} {
+		s := shape["s"].(float64)
+		return s * s
+	})
+
+	fmt.Println(Apply(area, map[string]interface{}{"kind": "circle", "r": 2.0}))
+	fmt.Println(Apply(area, map[string]interface{}{"kind": "square", "s": 3.0}))
+	// Output: 12
+	// 9
+}
+
+func ExampleMulti_derive() {
+	speak := NewMulti(func(kind string) interface{} { return kind })
+	speak.Define("animal", func(kind string) interface{} { return "..." })
+	speak.Define("dog", func(kind string) interface{} { return "woof" })
+	speak.Derive("dog", "animal")
+	speak.Derive("puppy", "dog")
+	speak.Derive("cat", "animal")
+
+	fmt.Println(Apply(speak, "dog"))
+	fmt.Println(Apply(speak, "puppy"))
+	fmt.Println(Apply(speak, "cat"))
+	// Output: woof
+	// woof
+	// ...
+}
+
+func ExampleMulti_default() {
+	m := NewMulti(func(x int) interface{} { return x })
+	m.Define(1, func(x int) interface{} { return "one" })
+	m.Define(Default, func(x int) interface{} { return "other" })
+
+	fmt.Println(Apply(m, 1))
+	fmt.Println(Apply(m, 2))
+	// Output: one
+	// other
+}
@@ -0,0 +1,127 @@
+package dyn
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Seq is a late bound sequence abstraction. Types that implement Seq
+// may be walked by Range without reflection.
+type Seq interface {
+	First() interface{}
+	Rest() Seq
+	Empty() bool
+}
+
+// Ranger is any type that knows how to walk its self and apply fn to
+// each element it contains, in the style Range otherwise provides
+// through reflection.
+type Ranger interface {
+	Range(fn interface{}) interface{}
+}
+
+// breakType is the type of the Break sentinel.
+type breakType struct{}
+
+// Break is returned from a Range/Reduce callback to stop iteration
+// early. A Range callback may return false to the same effect; a
+// Reduce callback that returns false just makes false the next
+// accumulator.
+var Break = breakType{}
+
+// shouldContinue interprets the value returned by a Range callback.
+// Iteration continues unless the callback returned false or Break.
+func shouldContinue(v interface{}) bool {
+	switch v {
+	case false, Break:
+		return false
+	default:
+		return true
+	}
+}
+
+// Range walks coll, applying fn to each element. If coll is a Ranger
+// then Range is called on it directly. If coll is a Seq then First and
+// Rest are used to walk it. Otherwise reflection is used to walk
+// slices, arrays, maps, channels, and structs; for maps fn is applied
+// to each key/value pair and for structs fn is applied to each field
+// name/value pair. Range stops as soon as fn returns false or Break,
+// and returns coll, except for a Ranger, whose own Range result is
+// returned.
+func Range(coll interface{}, fn interface{}) interface{} {
+	if r, ok := coll.(Ranger); ok {
+		return r.Range(fn)
+	}
+	if s, ok := coll.(Seq); ok {
+		rangeSeq(s, fn)
+		return coll
+	}
+	rangeReflect(reflect.ValueOf(coll), fn)
+	return coll
+}
+
+func rangeSeq(s Seq, fn interface{}) {
+	for !s.Empty() {
+		if !shouldContinue(Apply(fn, s.First())) {
+			return
+		}
+		s = s.Rest()
+	}
+}
+
+func rangeReflect(collv reflect.Value, fn interface{}) {
+	switch collv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < collv.Len(); i++ {
+			if !shouldContinue(Apply(fn, collv.Index(i).Interface())) {
+				return
+			}
+		}
+	case reflect.Map:
+		iter := collv.MapRange()
+		for iter.Next() {
+			if !shouldContinue(Apply(fn, iter.Key().Interface(), iter.Value().Interface())) {
+				return
+			}
+		}
+	case reflect.Chan:
+		for {
+			v, ok := collv.Recv()
+			if !ok {
+				return
+			}
+			if !shouldContinue(Apply(fn, v.Interface())) {
+				return
+			}
+		}
+	case reflect.Struct:
+		t := collv.Type()
+		for i := 0; i < collv.NumField(); i++ {
+			if !shouldContinue(Apply(fn, t.Field(i).Name, collv.Field(i).Interface())) {
+				return
+			}
+		}
+	case reflect.Ptr:
+		rangeReflect(collv.Elem(), fn)
+	default:
+		panic(errors.New("Range passed a non rangeable type"))
+	}
+}
+
+// Reduce walks coll with Range, threading an accumulator through fn.
+// fn is called with the accumulator followed by the element(s) Range
+// would otherwise pass to a plain callback, and must return the next
+// accumulator value. Reduce stops early if fn returns Break, in which
+// case the accumulator at the time of the Break is returned.
+func Reduce(coll interface{}, init interface{}, fn interface{}) interface{} {
+	acc := init
+	Range(coll, func(args ...interface{}) interface{} {
+		out := Apply(fn, PrependArg(acc, args...)...)
+		if out == Break {
+			return Break
+		}
+		acc = out
+		return true
+	})
+	return acc
+}
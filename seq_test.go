@@ -0,0 +1,78 @@
+package dyn
+
+import "fmt"
+
+func ExampleRange_slice() {
+	Range([]int{1, 2, 3}, func(x int) bool {
+		fmt.Println(x)
+		return true
+	})
+	// Output: 1
+	// 2
+	// 3
+}
+
+func ExampleRange_break() {
+	Range([]int{1, 2, 3, 4}, func(x int) interface{} {
+		if x == 3 {
+			return Break
+		}
+		fmt.Println(x)
+		return true
+	})
+	// Output: 1
+	// 2
+}
+
+func ExampleRange_struct() {
+	type point struct{ X, Y int }
+	Range(point{X: 1, Y: 2}, func(name string, value int) bool {
+		fmt.Println(name, value)
+		return true
+	})
+	// Output: X 1
+	// Y 2
+}
+
+type intSeq []int
+
+func (s intSeq) First() interface{} {
+	return s[0]
+}
+
+func (s intSeq) Rest() Seq {
+	return s[1:]
+}
+
+func (s intSeq) Empty() bool {
+	return len(s) == 0
+}
+
+func ExampleRange_seq() {
+	Range(intSeq{1, 2, 3}, func(x int) bool {
+		fmt.Println(x)
+		return true
+	})
+	// Output: 1
+	// 2
+	// 3
+}
+
+func ExampleReduce() {
+	sum := Reduce([]int{1, 2, 3, 4}, 0, func(acc, x int) int {
+		return acc + x
+	})
+	fmt.Println(sum)
+	// Output: 10
+}
+
+func ExampleReduce_break() {
+	sum := Reduce([]int{1, 2, 3, 4}, 0, func(acc, x int) interface{} {
+		if x == 3 {
+			return Break
+		}
+		return acc + x
+	})
+	fmt.Println(sum)
+	// Output: 3
+}